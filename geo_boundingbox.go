@@ -0,0 +1,79 @@
+package GeoHash
+
+// rectIntersects 判断两个经纬度矩形是否有交集.
+func rectIntersects(aMinLat, aMaxLat, aMinLng, aMaxLng, bMinLat, bMaxLat, bMinLng, bMaxLng float64) bool {
+	return aMinLat <= bMaxLat && aMaxLat >= bMinLat && aMinLng <= bMaxLng && aMaxLng >= bMinLng
+}
+
+// rectContains 判断 inner 矩形是否完全落在 outer 矩形内.
+func rectContains(outerMinLat, outerMaxLat, outerMinLng, outerMaxLng, innerMinLat, innerMaxLat, innerMinLng, innerMaxLng float64) bool {
+	return outerMinLat <= innerMinLat && outerMaxLat >= innerMaxLat && outerMinLng <= innerMinLng && outerMaxLng >= innerMaxLng
+}
+
+// filterEntryByBox 只保留 entry 中落在查询矩形内的点, 若过滤后为空则返回 nil.
+func filterEntryByBox(entry *GeoEntry, minLat, minLng, maxLat, maxLng float64) *GeoEntry {
+	filtered := &GeoEntry{Hash: entry.Hash}
+	for hash, pts := range entry.Points {
+		for _, p := range pts {
+			if p.Latitude >= minLat && p.Latitude <= maxLat && p.Longitude >= minLng && p.Longitude <= maxLng {
+				filtered.add(p, hash)
+			}
+		}
+	}
+	if filtered.Points == nil {
+		return nil
+	}
+	return filtered
+}
+
+// boundingBoxSearch 递归下降 trie, curMin/MaxLat/Lng 是当前节点代表的前缀所覆盖的矩形,
+// isLng 表示进入下一个子节点时第一比特是否为经度. 不相交的子树直接剪掉,
+// 完全被查询矩形包含的子树用 dfs 整体收集, 部分重叠的子树继续递归, 叶子节点逐点过滤.
+func (n *geoTireNode) boundingBoxSearch(curMinLat, curMaxLat, curMinLng, curMaxLng float64, isLng bool, qMinLat, qMinLng, qMaxLat, qMaxLng float64, out *[]*GeoEntry) error {
+	if !rectIntersects(curMinLat, curMaxLat, curMinLng, curMaxLng, qMinLat, qMaxLat, qMinLng, qMaxLng) {
+		return nil
+	}
+
+	if rectContains(qMinLat, qMaxLat, qMinLng, qMaxLng, curMinLat, curMaxLat, curMinLng, curMaxLng) {
+		entries, err := n.dfs()
+		if err != nil {
+			return err
+		}
+		*out = append(*out, entries...)
+		return nil
+	}
+
+	if n.end {
+		if filtered := filterEntryByBox(&n.GeoEntry, qMinLat, qMinLng, qMaxLat, qMaxLng); filtered != nil {
+			*out = append(*out, filtered)
+		}
+	}
+
+	for i, child := range n.children {
+		if child == nil {
+			continue
+		}
+		childMinLat, childMaxLat, childMinLng, childMaxLng, childIsLng := narrowRangeByValue(curMinLat, curMaxLat, curMinLng, curMaxLng, isLng, i)
+		if err := child.boundingBoxSearch(childMinLat, childMaxLat, childMinLng, childMaxLng, childIsLng, qMinLat, qMinLng, qMaxLat, qMaxLng, out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GeoBoundingBox 返回落在 [minLat, maxLat] x [minLng, maxLng] 矩形范围内的所有索引点,
+// 通过在 trie 上按前缀覆盖的矩形剪枝来避免全量扫描.
+func (t *TireTreeGeoService) GeoBoundingBox(minLat, minLng, maxLat, maxLng float64) ([]*GeoEntry, error) {
+	if minLat > maxLat || minLng > maxLng {
+		return nil, ErrInvalidBoundingBox
+	}
+
+	t.mux.RLock()
+	defer t.mux.RUnlock()
+
+	var result []*GeoEntry
+	if err := t.root.boundingBoxSearch(-90, 90, -180, 180, true, minLat, minLng, maxLat, maxLng, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
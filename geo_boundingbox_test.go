@@ -0,0 +1,78 @@
+package GeoHash
+
+import "testing"
+
+func TestGeoBoundingBox_FiltersPointsInBox(t *testing.T) {
+	svc := NewTireTreeGeoService()
+	inside := Points{Latitude: 10, Longitude: 10}
+	outside := Points{Latitude: 50, Longitude: 50}
+	if _, err := svc.GeoAdd(inside); err != nil {
+		t.Fatalf("GeoAdd returned error: %v", err)
+	}
+	if _, err := svc.GeoAdd(outside); err != nil {
+		t.Fatalf("GeoAdd returned error: %v", err)
+	}
+
+	tire := svc.(*TireTreeGeoService)
+	entries, err := tire.GeoBoundingBox(0, 0, 20, 20)
+	if err != nil {
+		t.Fatalf("GeoBoundingBox returned error: %v", err)
+	}
+
+	found, excluded := false, false
+	for _, e := range entries {
+		for _, pts := range e.Points {
+			for _, p := range pts {
+				if p == inside {
+					found = true
+				}
+				if p == outside {
+					excluded = true
+				}
+			}
+		}
+	}
+	if !found {
+		t.Fatalf("expected %v inside box, got entries %v", inside, entries)
+	}
+	if excluded {
+		t.Fatalf("did not expect %v outside box in result, got entries %v", outside, entries)
+	}
+}
+
+func TestGeoBoundingBox_FullyContainedSubtreeIsNotDropped(t *testing.T) {
+	svc := NewTireTreeGeoService()
+	p1 := Points{Latitude: 10.001, Longitude: 10.001}
+	p2 := Points{Latitude: 10.002, Longitude: 10.002}
+	if _, err := svc.GeoAdd(p1); err != nil {
+		t.Fatalf("GeoAdd returned error: %v", err)
+	}
+	if _, err := svc.GeoAdd(p2); err != nil {
+		t.Fatalf("GeoAdd returned error: %v", err)
+	}
+
+	tire := svc.(*TireTreeGeoService)
+	// 这个矩形完全覆盖两个点所在的子树, 应该命中 dfs 整体收集的分支.
+	entries, err := tire.GeoBoundingBox(0, 0, 20, 20)
+	if err != nil {
+		t.Fatalf("GeoBoundingBox returned error: %v", err)
+	}
+
+	count := 0
+	for _, e := range entries {
+		for _, pts := range e.Points {
+			count += len(pts)
+		}
+	}
+	if count != 2 {
+		t.Fatalf("expected 2 points from the fully-contained subtree, got %d: %v", count, entries)
+	}
+}
+
+func TestGeoBoundingBox_RejectsInvertedRange(t *testing.T) {
+	svc := NewTireTreeGeoService()
+	tire := svc.(*TireTreeGeoService)
+	if _, err := tire.GeoBoundingBox(20, 20, 0, 0); err != ErrInvalidBoundingBox {
+		t.Fatalf("expected ErrInvalidBoundingBox, got %v", err)
+	}
+}
@@ -0,0 +1,158 @@
+package GeoHash
+
+import (
+	"container/heap"
+	"errors"
+	"sort"
+)
+
+// NearbyPoint 是 GeoNearest 的一条结果: 命中点, 其所在格子的 geohash, 以及到查询中心的距离(米).
+type NearbyPoint struct {
+	Point     Points
+	Hash      string
+	DistanceM float64
+}
+
+// nearestHeap 是按距离由大到小排列的大顶堆, 用来维护当前最优的 k 个候选点.
+type nearestHeap []NearbyPoint
+
+func (h nearestHeap) Len() int            { return len(h) }
+func (h nearestHeap) Less(i, j int) bool  { return h[i].DistanceM > h[j].DistanceM }
+func (h nearestHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *nearestHeap) Push(x interface{}) { *h = append(*h, x.(NearbyPoint)) }
+func (h *nearestHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[:n-1]
+	return item
+}
+
+// pushBounded 把 np 纳入堆中, 堆大小不超过 k: 未满直接入堆, 已满则只在比当前最差候选更近时才替换.
+func pushBounded(h *nearestHeap, np NearbyPoint, k int) {
+	if h.Len() < k {
+		heap.Push(h, np)
+		return
+	}
+	if np.DistanceM < (*h)[0].DistanceM {
+		heap.Pop(h)
+		heap.Push(h, np)
+	}
+}
+
+// nearestBoundaryDistance 估算 center 到矩形边界的最近距离(米), 取上下左右四条边上最近点的 haversine 距离的最小值.
+func nearestBoundaryDistance(center Points, minLat, maxLat, minLng, maxLng float64) float64 {
+	dists := [4]float64{
+		haversineDistance(center, Points{Latitude: minLat, Longitude: center.Longitude}),
+		haversineDistance(center, Points{Latitude: maxLat, Longitude: center.Longitude}),
+		haversineDistance(center, Points{Latitude: center.Latitude, Longitude: minLng}),
+		haversineDistance(center, Points{Latitude: center.Latitude, Longitude: maxLng}),
+	}
+	min := dists[0]
+	for _, d := range dists[1:] {
+		if d < min {
+			min = d
+		}
+	}
+	return min
+}
+
+// GeoNearest 返回离 center 最近的 k 个索引点, 按距离升序排列.
+// 先沿 center 的 geohash 前缀下降到 passCnt >= k 的最深节点收集候选,
+// 再按 GeoRadius 的邻居格子规则逐层向外扩展, 直到堆中最差候选的距离已不超过 center 在当前层所在格子的边界距离为止
+// (该格子被已搜索区域包含, 所以这个停止条件偏保守).
+func (t *TireTreeGeoService) GeoNearest(center Points, k int) ([]NearbyPoint, error) {
+	if k <= 0 {
+		return nil, errors.New("k must be positive")
+	}
+
+	t.mux.RLock()
+	defer t.mux.RUnlock()
+
+	hash, err := t.GeoHash(center)
+	if err != nil {
+		return nil, err
+	}
+
+	depth := 0
+	node := t.root
+	for depth < len(hash) {
+		child := node.children[t.base32ToIndex(hash[depth])]
+		if child == nil || child.passCnt < k {
+			break
+		}
+		node = child
+		depth++
+	}
+
+	visited := map[*GeoEntry]struct{}{}
+	h := &nearestHeap{}
+
+	collect := func(n *geoTireNode) error {
+		entries, err := n.dfs()
+		if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			if _, ok := visited[e]; ok {
+				continue
+			}
+			visited[e] = struct{}{}
+			for hash, pts := range e.Points {
+				for _, p := range pts {
+					pushBounded(h, NearbyPoint{Point: p, Hash: hash, DistanceM: haversineDistance(center, p)}, k)
+				}
+			}
+		}
+		return nil
+	}
+
+	if err := collect(node); err != nil {
+		return nil, err
+	}
+
+	for depth > 0 {
+		minLat, maxLat, minLng, maxLng, err := DecodeHash(hash[:depth])
+		if err != nil {
+			return nil, err
+		}
+		if h.Len() >= k && (*h)[0].DistanceM <= nearestBoundaryDistance(center, minLat, maxLat, minLng, maxLng) {
+			break
+		}
+
+		depth--
+		prefix := hash[:depth]
+		pMinLat, pMaxLat, pMinLng, pMaxLng, err := DecodeHash(prefix)
+		if err != nil {
+			return nil, err
+		}
+		cellLat := pMaxLat - pMinLat
+		cellLng := pMaxLng - pMinLng
+
+		seenPrefix := map[string]struct{}{}
+		for _, cell := range neighborCells(center, cellLat, cellLng) {
+			neighborHash, err := t.GeoHash(cell)
+			if err != nil {
+				return nil, err
+			}
+			neighborPrefix := neighborHash[:len(prefix)]
+			if _, ok := seenPrefix[neighborPrefix]; ok {
+				continue
+			}
+			seenPrefix[neighborPrefix] = struct{}{}
+
+			neighborNode, err := t.get(neighborPrefix)
+			if err != nil || neighborNode == nil {
+				continue
+			}
+			if err := collect(neighborNode); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	result := make([]NearbyPoint, len(*h))
+	copy(result, *h)
+	sort.Slice(result, func(i, j int) bool { return result[i].DistanceM < result[j].DistanceM })
+	return result, nil
+}
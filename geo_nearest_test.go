@@ -0,0 +1,33 @@
+package GeoHash
+
+import "testing"
+
+func TestGeoNearest_OrdersByDistance(t *testing.T) {
+	svc := NewTireTreeGeoService()
+	beijing := Points{Latitude: 39.92816, Longitude: 116.38804}
+	points := []Points{
+		beijing,
+		{Latitude: 39.93, Longitude: 116.39},     // 很近
+		{Latitude: 31.23039, Longitude: 121.47370}, // 上海, 较远
+	}
+	for _, p := range points {
+		if _, err := svc.GeoAdd(p); err != nil {
+			t.Fatalf("GeoAdd returned error: %v", err)
+		}
+	}
+
+	tire := svc.(*TireTreeGeoService)
+	nearest, err := tire.GeoNearest(beijing, 2)
+	if err != nil {
+		t.Fatalf("GeoNearest returned error: %v", err)
+	}
+	if len(nearest) != 2 {
+		t.Fatalf("expected 2 results, got %d: %v", len(nearest), nearest)
+	}
+	if nearest[0].DistanceM > nearest[1].DistanceM {
+		t.Fatalf("results not sorted ascending by distance: %v", nearest)
+	}
+	if nearest[0].Point != beijing {
+		t.Fatalf("expected closest point to be %v, got %v", beijing, nearest[0].Point)
+	}
+}
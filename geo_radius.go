@@ -0,0 +1,168 @@
+package GeoHash
+
+import (
+	"errors"
+	"math"
+)
+
+const earthRadiusMeters = 6371000.0
+
+// base32Index 是 Base32 表的反查: 字符 -> 5bit 数值, 用于解码 geohash.
+var base32Index = func() map[byte]int {
+	m := make(map[byte]int, len(Base32))
+	for i, c := range Base32 {
+		m[c] = i
+	}
+	return m
+}()
+
+// geohashCellDims 按 geohash 长度给出单元格的近似经纬跨度(米), 用于根据半径反推精度.
+// 数值来自标准 geohash 精度表.
+var geohashCellDims = map[int]struct{ latM, lngM float64 }{
+	1: {4992600, 5009400},
+	2: {624100, 1252300},
+	3: {156000, 156500},
+	4: {19500, 39100},
+	5: {4900, 4900},
+	6: {610, 1200},
+	7: {152.4, 152.9},
+	8: {19, 38.2},
+}
+
+// precisionForRadius 选出满足 cell size >= radiusMeters 的最大 geohash 长度(不超过 maxLen),
+// 这样以该精度的 3x3 邻域即可覆盖半径为 radiusMeters 的圆.
+func precisionForRadius(radiusMeters float64, maxLen int) int {
+	length := 1
+	for l := 1; l <= maxLen; l++ {
+		dims, ok := geohashCellDims[l]
+		if !ok {
+			break
+		}
+		if dims.latM < radiusMeters || dims.lngM < radiusMeters {
+			break
+		}
+		length = l
+	}
+	return length
+}
+
+// narrowRangeByValue 消费一个 5bit 的 base32 值, 按经纬交错规则收缩当前区间.
+func narrowRangeByValue(minLat, maxLat, minLng, maxLng float64, isLng bool, value int) (float64, float64, float64, float64, bool) {
+	for bit := 4; bit >= 0; bit-- {
+		b := (value >> uint(bit)) & 1
+		if isLng {
+			mid := (minLng + maxLng) / 2
+			if b == 1 {
+				minLng = mid
+			} else {
+				maxLng = mid
+			}
+		} else {
+			mid := (minLat + maxLat) / 2
+			if b == 1 {
+				minLat = mid
+			} else {
+				maxLat = mid
+			}
+		}
+		isLng = !isLng
+	}
+	return minLat, maxLat, minLng, maxLng, isLng
+}
+
+// wrapLongitude 将经度归一化到 [-180, 180].
+func wrapLongitude(lng float64) float64 {
+	for lng > 180 {
+		lng -= 360
+	}
+	for lng < -180 {
+		lng += 360
+	}
+	return lng
+}
+
+// neighborCells 返回 center 自身以及把它沿纬度/经度各平移 0 或 ±cellLat/±cellLng 得到的 3x3 个坐标点,
+// 各点分别落在 center 所在格子及其 8 个邻居格子内(不是格心坐标),
+// 经度按 ±180 折返, 会越过极点的邻居格子直接丢弃.
+func neighborCells(center Points, cellLat, cellLng float64) []Points {
+	var cells []Points
+	offsets := []float64{-1, 0, 1}
+	for _, dLat := range offsets {
+		for _, dLng := range offsets {
+			lat := center.Latitude + dLat*cellLat
+			if lat > 90 || lat < -90 {
+				continue // 越过极点的邻居格子没有意义, 丢弃
+			}
+			lng := wrapLongitude(center.Longitude + dLng*cellLng)
+			cells = append(cells, Points{Latitude: lat, Longitude: lng})
+		}
+	}
+	return cells
+}
+
+// haversineDistance 计算两点间的球面距离(米), R 取地球平均半径 6371000m.
+func haversineDistance(a, b Points) float64 {
+	phi1 := a.Latitude * math.Pi / 180
+	phi2 := b.Latitude * math.Pi / 180
+	dPhi := (b.Latitude - a.Latitude) * math.Pi / 180
+	dLambda := (b.Longitude - a.Longitude) * math.Pi / 180
+
+	sinDPhi := math.Sin(dPhi / 2)
+	sinDLambda := math.Sin(dLambda / 2)
+	h := sinDPhi*sinDPhi + math.Cos(phi1)*math.Cos(phi2)*sinDLambda*sinDLambda
+	return 2 * earthRadiusMeters * math.Asin(math.Sqrt(h))
+}
+
+// GeoRadius 返回以 center 为中心, radiusMeters 米范围内的所有索引点.
+// 先按半径选取一个 cell size >= radius 的精度, 用中心及其 8 个邻居格子的前缀去 trie 里查候选点,
+// 再用 haversine 距离逐一过滤.
+func (t *TireTreeGeoService) GeoRadius(center Points, radiusMeters float64) ([]Points, error) {
+	if radiusMeters <= 0 {
+		return nil, errors.New("radiusMeters must be positive")
+	}
+
+	centerHash, err := t.GeoHash(center)
+	if err != nil {
+		return nil, err
+	}
+
+	length := precisionForRadius(radiusMeters, len(centerHash))
+	centerPrefix := centerHash[:length]
+
+	minLat, maxLat, minLng, maxLng, err := DecodeHash(centerPrefix)
+	if err != nil {
+		return nil, err
+	}
+	cellLat := maxLat - minLat
+	cellLng := maxLng - minLng
+
+	prefixes := map[string]struct{}{}
+	for _, cell := range neighborCells(center, cellLat, cellLng) {
+		cellHash, err := t.GeoHash(cell)
+		if err != nil {
+			return nil, err
+		}
+		prefixes[cellHash[:length]] = struct{}{}
+	}
+
+	var result []Points
+	for prefix := range prefixes {
+		entries, err := t.FindByPrefix(prefix)
+		if err == ErrInvalidHash {
+			continue // 该邻居格子还没有被索引过, 跳过即可
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			for _, pts := range entry.Points {
+				for _, p := range pts {
+					if haversineDistance(center, p) <= radiusMeters {
+						result = append(result, p)
+					}
+				}
+			}
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,65 @@
+package GeoHash
+
+import "testing"
+
+func TestGeoRadius_FindsPointsWithinRadius(t *testing.T) {
+	svc := NewTireTreeGeoService()
+	near := Points{Latitude: 39.92816, Longitude: 116.38804}
+	far := Points{Latitude: 31.23039, Longitude: 121.47370} // 上海, 远在千里之外
+	if _, err := svc.GeoAdd(near); err != nil {
+		t.Fatalf("GeoAdd returned error: %v", err)
+	}
+	if _, err := svc.GeoAdd(far); err != nil {
+		t.Fatalf("GeoAdd returned error: %v", err)
+	}
+
+	center := Points{Latitude: 39.928, Longitude: 116.389}
+	results, err := svc.GeoRadius(center, 2000)
+	if err != nil {
+		t.Fatalf("GeoRadius returned error: %v", err)
+	}
+
+	found := false
+	for _, p := range results {
+		if p == near {
+			found = true
+		}
+		if p == far {
+			t.Fatalf("unexpected out-of-radius point in result: %v", p)
+		}
+	}
+	if !found {
+		t.Fatalf("expected %v in radius result, got %v", near, results)
+	}
+}
+
+func TestGeoRadius_WrapsAroundAntimeridian(t *testing.T) {
+	svc := NewTireTreeGeoService()
+	p := Points{Latitude: 0, Longitude: 179.999}
+	if _, err := svc.GeoAdd(p); err != nil {
+		t.Fatalf("GeoAdd returned error: %v", err)
+	}
+
+	center := Points{Latitude: 0, Longitude: -179.999}
+	results, err := svc.GeoRadius(center, 2000)
+	if err != nil {
+		t.Fatalf("GeoRadius returned error: %v", err)
+	}
+
+	found := false
+	for _, r := range results {
+		if r == p {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected antimeridian-adjacent point %v to be found, got %v", p, results)
+	}
+}
+
+func TestGeoRadius_RejectsNonPositiveRadius(t *testing.T) {
+	svc := NewTireTreeGeoService()
+	if _, err := svc.GeoRadius(Points{Latitude: 0, Longitude: 0}, 0); err == nil {
+		t.Fatal("expected error for radiusMeters=0")
+	}
+}
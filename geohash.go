@@ -0,0 +1,89 @@
+package GeoHash
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidPrecision = errors.New("chars must be between 1 and 12")
+
+var Base32 = []byte{'0', '1', '2', '3', '4', '5', '6', '7', '8', '9',
+	'B', 'C', 'D', 'E', 'F', 'G', 'H', 'J', 'K', 'M', 'N', 'P', 'Q',
+	'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z'}
+
+// GeoHash 对 points 以默认精度(8位, 40bit)编码.
+func (t *TireTreeGeoService) GeoHash(points Points) (string, error) {
+	return t.GeoHashWithPrecision(points, 8)
+}
+
+// GeoHashWithPrecision 对 points 编码为 chars 位的 base32 geohash(1~12位).
+// 按标准 geohash 规则交错比特: 偶数位(从0开始)是经度, 奇数位是纬度.
+func (t *TireTreeGeoService) GeoHashWithPrecision(points Points, chars int) (string, error) {
+	if chars < 1 || chars > 12 {
+		return "", ErrInvalidPrecision
+	}
+
+	totalBits := chars * 5
+	lngBitCount := (totalBits + 1) / 2 // 第一个比特是经度, 总位数为奇数时经度多占一位
+	latBitCount := totalBits / 2
+
+	lngBits := t.getBinaryBits(points.Longitude, -180, 180, lngBitCount)
+	latBits := t.getBinaryBits(points.Latitude, -90, 90, latBitCount)
+
+	var geoHash strings.Builder
+	var fiveBitsTmp strings.Builder
+	lngIdx, latIdx := 0, 0
+	for i := 0; i < totalBits; i++ {
+		if i%2 == 0 {
+			fiveBitsTmp.WriteByte(lngBits[lngIdx])
+			lngIdx++
+		} else {
+			fiveBitsTmp.WriteByte(latBits[latIdx])
+			latIdx++
+		}
+
+		if fiveBitsTmp.Len() != 5 {
+			continue
+		}
+
+		val, err := strconv.ParseInt(fiveBitsTmp.String(), 2, 64)
+		if err != nil {
+			return "", err
+		}
+		geoHash.WriteByte(Base32[val])
+		fiveBitsTmp.Reset()
+	}
+	return geoHash.String(), nil
+}
+
+// DecodeHash 解码一个 geohash(或其前缀)所覆盖的经纬度矩形.
+func DecodeHash(hash string) (minLat, maxLat, minLng, maxLng float64, err error) {
+	minLat, maxLat = -90, 90
+	minLng, maxLng = -180, 180
+	isLng := true
+	for i := 0; i < len(hash); i++ {
+		val, ok := base32Index[hash[i]]
+		if !ok {
+			return 0, 0, 0, 0, ErrInvalidHash
+		}
+		minLat, maxLat, minLng, maxLng, isLng = narrowRangeByValue(minLat, maxLat, minLng, maxLng, isLng, val)
+	}
+	return minLat, maxLat, minLng, maxLng, nil
+}
+
+// getBinaryBits 对 val 在 [start, end) 区间内做 bitCount 次二分, 返回 "0"/"1" 组成的比特串.
+func (t *TireTreeGeoService) getBinaryBits(val, start, end float64, bitCount int) string {
+	var bits strings.Builder
+	for i := 0; i < bitCount; i++ {
+		mid := (start + end) / 2
+		if val < mid {
+			bits.WriteString("0")
+			end = mid
+		} else {
+			bits.WriteString("1")
+			start = mid
+		}
+	}
+	return bits.String()
+}
@@ -0,0 +1,44 @@
+package GeoHash
+
+import "testing"
+
+func TestGeoHash_ReferenceEncoding(t *testing.T) {
+	svc := &TireTreeGeoService{}
+	hash, err := svc.GeoHash(Points{Latitude: 39.92816, Longitude: 116.38804})
+	if err != nil {
+		t.Fatalf("GeoHash returned error: %v", err)
+	}
+	if len(hash) != 8 {
+		t.Fatalf("expected 8 chars, got %q", hash)
+	}
+	if hash[:5] != "WX4G0" {
+		t.Fatalf("expected prefix WX4G0, got %q", hash)
+	}
+}
+
+func TestGeoHashWithPrecision_InvalidChars(t *testing.T) {
+	svc := &TireTreeGeoService{}
+	if _, err := svc.GeoHashWithPrecision(Points{Latitude: 0, Longitude: 0}, 0); err == nil {
+		t.Fatal("expected error for chars=0")
+	}
+	if _, err := svc.GeoHashWithPrecision(Points{Latitude: 0, Longitude: 0}, 13); err == nil {
+		t.Fatal("expected error for chars=13")
+	}
+}
+
+func TestDecodeHash_ContainsOriginalPoint(t *testing.T) {
+	svc := &TireTreeGeoService{}
+	p := Points{Latitude: 39.92816, Longitude: 116.38804}
+	hash, err := svc.GeoHash(p)
+	if err != nil {
+		t.Fatalf("GeoHash returned error: %v", err)
+	}
+
+	minLat, maxLat, minLng, maxLng, err := DecodeHash(hash)
+	if err != nil {
+		t.Fatalf("DecodeHash returned error: %v", err)
+	}
+	if p.Latitude < minLat || p.Latitude > maxLat || p.Longitude < minLng || p.Longitude > maxLng {
+		t.Fatalf("decoded cell [%v,%v]x[%v,%v] does not contain original point %v", minLat, maxLat, minLng, maxLng, p)
+	}
+}
@@ -0,0 +1,199 @@
+package GeoHash
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+var ErrInvalidSnapshot = errors.New("invalid snapshot")
+
+const (
+	snapshotMagic   = "GEOT"
+	snapshotVersion = 1
+)
+
+// Snapshot 把当前 trie 以二进制格式写入 w, 可配合 Restore 做热重启.
+// 格式: 4字节 magic + 1字节版本号, 之后是前序遍历的节点序列, 每个节点:
+// 4字节子节点位图(32个子节点各占1bit) + varint passCnt + 1字节 end 标记,
+// end 为真时额外写入 geohash 字符串(varint长度前缀)和 varint 个数的坐标点(每个点两个小端 float64: lat, lng).
+func (t *TireTreeGeoService) Snapshot(w io.Writer) error {
+	t.mux.RLock()
+	defer t.mux.RUnlock()
+
+	bw := bufio.NewWriter(w)
+	if _, err := bw.WriteString(snapshotMagic); err != nil {
+		return err
+	}
+	if err := bw.WriteByte(snapshotVersion); err != nil {
+		return err
+	}
+	if err := writeSnapshotNode(bw, t.root, ""); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// writeSnapshotNode 前序写出 n, prefix 是从根到 n 的路径对应的 geohash 前缀(即 n 自己的 geohash).
+func writeSnapshotNode(w *bufio.Writer, n *geoTireNode, prefix string) error {
+	var bitmap uint32
+	for i, child := range n.children {
+		if child != nil {
+			bitmap |= 1 << uint(i)
+		}
+	}
+	if err := binary.Write(w, binary.LittleEndian, bitmap); err != nil {
+		return err
+	}
+	if err := writeUvarint(w, uint64(n.passCnt)); err != nil {
+		return err
+	}
+	var endByte byte
+	if n.end {
+		endByte = 1
+	}
+	if err := w.WriteByte(endByte); err != nil {
+		return err
+	}
+
+	if n.end {
+		if err := writeString(w, prefix); err != nil {
+			return err
+		}
+		var points []Points
+		for _, pts := range n.GeoEntry.Points {
+			points = append(points, pts...)
+		}
+		if err := writeUvarint(w, uint64(len(points))); err != nil {
+			return err
+		}
+		for _, p := range points {
+			if err := binary.Write(w, binary.LittleEndian, p.Latitude); err != nil {
+				return err
+			}
+			if err := binary.Write(w, binary.LittleEndian, p.Longitude); err != nil {
+				return err
+			}
+		}
+	}
+
+	for i := 0; i < len(n.children); i++ {
+		if n.children[i] == nil {
+			continue
+		}
+		if err := writeSnapshotNode(w, n.children[i], prefix+string(Base32[i])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeUvarint(w io.Writer, v uint64) error {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], v)
+	_, err := w.Write(buf[:n])
+	return err
+}
+
+func writeString(w io.Writer, s string) error {
+	if err := writeUvarint(w, uint64(len(s))); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, s)
+	return err
+}
+
+// Restore 从 r 读取 Snapshot 写出的二进制格式, 重建整棵 trie.
+func (t *TireTreeGeoService) Restore(r io.Reader) error {
+	br := bufio.NewReader(r)
+
+	magic := make([]byte, len(snapshotMagic))
+	if _, err := io.ReadFull(br, magic); err != nil {
+		return err
+	}
+	if string(magic) != snapshotMagic {
+		return ErrInvalidSnapshot
+	}
+	version, err := br.ReadByte()
+	if err != nil {
+		return err
+	}
+	if version != snapshotVersion {
+		return ErrInvalidSnapshot
+	}
+
+	root, err := readSnapshotNode(br, "")
+	if err != nil {
+		return err
+	}
+
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	t.root = root
+	return nil
+}
+
+// readSnapshotNode 前序读回一个节点, prefix 是从根到该节点的路径对应的 geohash 前缀(即该节点自己的 geohash).
+func readSnapshotNode(r *bufio.Reader, prefix string) (*geoTireNode, error) {
+	var bitmap uint32
+	if err := binary.Read(r, binary.LittleEndian, &bitmap); err != nil {
+		return nil, err
+	}
+	passCnt, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	endByte, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+
+	node := &geoTireNode{passCnt: int(passCnt), end: endByte == 1}
+	if node.end {
+		hash, err := readString(r)
+		if err != nil {
+			return nil, err
+		}
+		node.GeoEntry.Hash = hash
+
+		count, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, err
+		}
+		for i := uint64(0); i < count; i++ {
+			var lat, lng float64
+			if err := binary.Read(r, binary.LittleEndian, &lat); err != nil {
+				return nil, err
+			}
+			if err := binary.Read(r, binary.LittleEndian, &lng); err != nil {
+				return nil, err
+			}
+			node.GeoEntry.add(Points{Latitude: lat, Longitude: lng}, hash)
+		}
+	}
+
+	for i := 0; i < 32; i++ {
+		if bitmap&(1<<uint(i)) == 0 {
+			continue
+		}
+		child, err := readSnapshotNode(r, prefix+string(Base32[i]))
+		if err != nil {
+			return nil, err
+		}
+		node.children[i] = child
+	}
+	return node, nil
+}
+
+func readString(r *bufio.Reader) (string, error) {
+	length, err := binary.ReadUvarint(r)
+	if err != nil {
+		return "", err
+	}
+	buf := make([]byte, length)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return "", err
+	}
+	return string(buf), nil
+}
@@ -0,0 +1,51 @@
+package GeoHash
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSnapshotRestore_RoundTrip(t *testing.T) {
+	svc := NewTireTreeGeoService()
+	points := []Points{
+		{Latitude: 39.92816, Longitude: 116.38804},
+		{Latitude: 31.23039, Longitude: 121.47370},
+		{Latitude: -33.86785, Longitude: 151.20732},
+	}
+	for _, p := range points {
+		if _, err := svc.GeoAdd(p); err != nil {
+			t.Fatalf("GeoAdd returned error: %v", err)
+		}
+	}
+
+	var buf bytes.Buffer
+	tire := svc.(*TireTreeGeoService)
+	if err := tire.Snapshot(&buf); err != nil {
+		t.Fatalf("Snapshot returned error: %v", err)
+	}
+
+	restored := &TireTreeGeoService{}
+	if err := restored.Restore(&buf); err != nil {
+		t.Fatalf("Restore returned error: %v", err)
+	}
+
+	for _, p := range points {
+		hash, err := restored.GeoHash(p)
+		if err != nil {
+			t.Fatalf("GeoHash returned error: %v", err)
+		}
+		got, err := restored.GeoPosition(hash)
+		if err != nil {
+			t.Fatalf("GeoPosition returned error: %v", err)
+		}
+		found := false
+		for _, gp := range got {
+			if gp == p {
+				found = true
+			}
+		}
+		if !found {
+			t.Fatalf("point %v missing after restore, got %v", p, got)
+		}
+	}
+}
@@ -3,13 +3,12 @@ package GeoHash
 import (
 	"errors"
 	"log"
-	"strconv"
-	"strings"
 	"sync"
 )
 
 var (
-	ErrInvalidHash = errors.New("invalid hash")
+	ErrInvalidHash        = errors.New("invalid hash")
+	ErrInvalidBoundingBox = errors.New("invalid bounding box")
 )
 
 type geoTireNode struct {
@@ -132,10 +131,10 @@ func (t *TireTreeGeoService) GeoAdd(points Points) (bool, error) {
 	t.mux.Lock()
 	defer t.mux.Unlock()
 	// 先判断是否已经存在, 存在则添加
-	target, err := t.get(geoHash)
+	target, _ := t.get(geoHash)
 	if target != nil && target.end {
 		target.GeoEntry.add(points, geoHash) // 存在则添加
-		return true, err
+		return true, nil
 	}
 	// 不存在则需要遍历插入
 	move := t.root
@@ -150,35 +149,7 @@ func (t *TireTreeGeoService) GeoAdd(points Points) (bool, error) {
 	// 最后一个节点
 	move.end = true
 	move.GeoEntry.add(points, geoHash)
-	return true, err
-}
-
-func (t *TireTreeGeoService) GeoHash(points Points) (string, error) {
-	lngBits := t.getBinaryBits(&strings.Builder{}, points.Longitude, -180, 180)
-	latBits := t.getBinaryBits(&strings.Builder{}, points.Latitude, -90, 90)
-
-	// 经纬度交错安放, 没5个一组
-	var geoHash strings.Builder
-	var fiveBitsTmp strings.Builder
-	for i := 0; i < 40; i++ {
-		if i%1 == 1 {
-			fiveBitsTmp.WriteByte(lngBits[(i-1)>>1])
-		} else if i%2 == 0 {
-			fiveBitsTmp.WriteByte(latBits[(i-1)>>1])
-		}
-
-		if i%5 != 0 {
-			continue
-		}
-
-		val, err := strconv.ParseInt(fiveBitsTmp.String(), 2, 64)
-		if err != nil {
-			return "", err
-		}
-		geoHash.WriteByte(Base32[val])
-		fiveBitsTmp.Reset()
-	}
-	return geoHash.String(), nil
+	return true, nil
 }
 
 func (t *TireTreeGeoService) FindByPrefix(prefix string) ([]*GeoEntry, error) {
@@ -193,44 +164,12 @@ func (t *TireTreeGeoService) FindByPrefix(prefix string) ([]*GeoEntry, error) {
 }
 
 func (t *TireTreeGeoService) GeoDistance(points Points, points2 Points) (error, float64) {
-	//TODO implement me
-	panic("implement me")
+	return nil, haversineDistance(points, points2)
 }
 
-var Base32 = []byte{'0', '1', '2', '3', '4', '5', '6', '7', '8', '9',
-	'B', 'C', 'D', 'E', 'F', 'G', 'H', 'J', 'K', 'M', 'N', 'P', 'Q',
-	'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z'}
-
 func (t *TireTreeGeoService) base32ToIndex(bits byte) int {
-	if bits >= '0' && bits <= '9' {
-		return int(bits - '0')
-	}
-	if bits >= 'B' && bits <= 'H' {
-		return int(bits - 'B' + 26)
-	}
-	if bits >= 'J' && bits <= 'K' {
-		return int(bits - 'J' + 33)
-	}
-	if bits >= 'M' && bits <= 'N' {
-		return int(bits - 'J' + 35)
-	}
-	if bits >= 'P' && bits <= 'Z' {
-		return int(bits - 'J' + 37)
+	if idx, ok := base32Index[bits]; ok {
+		return idx
 	}
 	return -1
 }
-
-func (t *TireTreeGeoService) getBinaryBits(bits *strings.Builder, val, start, end float64) string {
-	mid := (start + end) / 2
-	if val < mid {
-		bits.WriteString("0")
-		end = mid
-	} else {
-		bits.WriteString("1")
-		start = mid
-	}
-	if bits.Len() >= 20 {
-		return bits.String()
-	}
-	return t.getBinaryBits(bits, val, start, end)
-}
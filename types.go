@@ -6,6 +6,7 @@ type GeoService interface {
 	GeoDistance(Points, Points) (error, float64)
 	GeoPosition(string) ([]Points, error)
 	GeoDel(string) (bool, error)
+	GeoRadius(center Points, radiusMeters float64) ([]Points, error)
 }
 
 type Points struct {